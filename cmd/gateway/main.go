@@ -0,0 +1,109 @@
+// Command gateway discovers stringsvc instances via Consul and fronts them
+// with a load-balancing, retrying HTTP API that exposes the same
+// /uppercase, /count, /reverse and /truncate routes as the backend service
+// itself.
+package main
+
+import (
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/sd"
+	consulsd "github.com/go-kit/kit/sd/consul"
+	"github.com/go-kit/kit/sd/lb"
+	"github.com/go-kit/log"
+	"github.com/hashicorp/consul/api"
+
+	endpoints "github.com/daniel-bryant-uk/go-kit-exp/endpoint"
+	httptransport "github.com/daniel-bryant-uk/go-kit-exp/transport/http"
+)
+
+const serviceName = "stringsvc"
+
+func main() {
+	var (
+		httpAddr    = flag.String("http.addr", ":8000", "HTTP listen address for the gateway")
+		consulAddr  = flag.String("consul.addr", "localhost:8500", "Consul agent address")
+		retryMax    = flag.Int("retry.max", 3, "max retries per request across instances")
+		retryTimout = flag.Duration("retry.timeout", 500*time.Millisecond, "per-request retry timeout")
+	)
+	flag.Parse()
+
+	logger := log.NewLogfmtLogger(log.NewSyncWriter(os.Stderr))
+	logger = log.With(logger, "ts", log.DefaultTimestampUTC, "component", "gateway")
+
+	consulConfig := api.DefaultConfig()
+	consulConfig.Address = *consulAddr
+	consulClient, err := api.NewClient(consulConfig)
+	if err != nil {
+		logger.Log("fatal", err)
+		os.Exit(1)
+	}
+	client := consulsd.NewClient(consulClient)
+
+	// All four methods are served by the same set of stringsvc instances,
+	// so they share a single Consul watch (instancer) and a single cache of
+	// per-instance HTTP clients, rather than each standing up its own.
+	instancer := consulsd.NewInstancer(client, logger, serviceName, []string{}, true)
+	defer instancer.Stop()
+	clients := newHTTPClientCache()
+
+	eps := endpoints.Endpoints{
+		UppercaseEndpoint: makeRetryEndpoint(instancer, clients, logger, *retryMax, *retryTimout, func(e endpoints.Endpoints) endpoint.Endpoint { return e.UppercaseEndpoint }),
+		CountEndpoint:     makeRetryEndpoint(instancer, clients, logger, *retryMax, *retryTimout, func(e endpoints.Endpoints) endpoint.Endpoint { return e.CountEndpoint }),
+		ReverseEndpoint:   makeRetryEndpoint(instancer, clients, logger, *retryMax, *retryTimout, func(e endpoints.Endpoints) endpoint.Endpoint { return e.ReverseEndpoint }),
+		TruncateEndpoint:  makeRetryEndpoint(instancer, clients, logger, *retryMax, *retryTimout, func(e endpoints.Endpoints) endpoint.Endpoint { return e.TruncateEndpoint }),
+	}
+
+	logger.Log("msg", "listening", "addr", *httpAddr)
+	logger.Log("fatal", http.ListenAndServe(*httpAddr, httptransport.NewHTTPHandler(eps)))
+}
+
+// makeRetryEndpoint builds an endpoint that discovers stringsvc instances
+// via instancer, load-balances across them round-robin, and retries against
+// a different instance on failure.
+func makeRetryEndpoint(instancer sd.Instancer, clients *httpClientCache, logger log.Logger, retryMax int, retryTimeout time.Duration, pick func(endpoints.Endpoints) endpoint.Endpoint) endpoint.Endpoint {
+	factory := func(instance string) (endpoint.Endpoint, io.Closer, error) {
+		eps, err := clients.get(instance)
+		if err != nil {
+			return nil, nil, err
+		}
+		return pick(eps), nil, nil
+	}
+
+	endpointer := sd.NewEndpointer(instancer, factory, logger)
+	balancer := lb.NewRoundRobin(endpointer)
+	return lb.Retry(retryMax, retryTimeout, balancer)
+}
+
+// httpClientCache builds at most one endpoints.Endpoints per discovered
+// instance, shared across all four methods' factories, instead of each
+// method constructing and discarding its own.
+type httpClientCache struct {
+	mtx sync.Mutex
+	eps map[string]endpoints.Endpoints
+}
+
+func newHTTPClientCache() *httpClientCache {
+	return &httpClientCache{eps: map[string]endpoints.Endpoints{}}
+}
+
+func (c *httpClientCache) get(instance string) (endpoints.Endpoints, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if eps, ok := c.eps[instance]; ok {
+		return eps, nil
+	}
+	eps, err := httptransport.NewHTTPClient("http://" + instance)
+	if err != nil {
+		return endpoints.Endpoints{}, err
+	}
+	c.eps[instance] = eps
+	return eps, nil
+}
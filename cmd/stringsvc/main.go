@@ -0,0 +1,201 @@
+// Command stringsvc wires up the string service and exposes it over HTTP,
+// gRPC, and (optionally) NATS.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+
+	"github.com/go-kit/log"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	consulsd "github.com/go-kit/kit/sd/consul"
+	"github.com/hashicorp/consul/api"
+	"github.com/nats-io/nats.go"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+
+	"github.com/daniel-bryant-uk/go-kit-exp/endpoint"
+	"github.com/daniel-bryant-uk/go-kit-exp/stringservice"
+	"github.com/daniel-bryant-uk/go-kit-exp/tracing"
+	grpctransport "github.com/daniel-bryant-uk/go-kit-exp/transport/grpc"
+	"github.com/daniel-bryant-uk/go-kit-exp/transport/grpc/pb"
+	httptransport "github.com/daniel-bryant-uk/go-kit-exp/transport/http"
+	natstransport "github.com/daniel-bryant-uk/go-kit-exp/transport/nats"
+)
+
+func main() {
+	var (
+		httpAddr   = flag.String("http.addr", ":8080", "HTTP listen address")
+		grpcAddr   = flag.String("grpc.addr", ":8081", "gRPC listen address")
+		natsAddr   = flag.String("nats.addr", "", "NATS server address (disabled if empty)")
+		consulAddr = flag.String("consul.addr", "", "Consul agent address for self-registration (disabled if empty)")
+		advertise  = flag.String("advertise.addr", "", "host:port advertised to Consul (defaults to the local hostname and http.addr's port)")
+	)
+	flag.Parse()
+
+	logger := log.NewLogfmtLogger(log.NewSyncWriter(os.Stderr))
+	logger = log.With(logger, "ts", log.DefaultTimestampUTC, "caller", log.DefaultCaller)
+
+	fieldKeys := []string{"method"}
+	requestCount := kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace: "stringsvc",
+		Subsystem: "string_service",
+		Name:      "request_count",
+		Help:      "Number of requests received.",
+	}, fieldKeys)
+	requestLatency := kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
+		Namespace: "stringsvc",
+		Subsystem: "string_service",
+		Name:      "request_latency_seconds",
+		Help:      "Total duration of requests in seconds.",
+	}, fieldKeys)
+	errorCount := kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace: "stringsvc",
+		Subsystem: "string_service",
+		Name:      "error_count",
+		Help:      "Number of errors encountered.",
+	}, fieldKeys)
+
+	tracerProvider, err := tracing.NewTracerProvider(context.Background(), "stringsvc")
+	if err != nil {
+		logger.Log("fatal", err)
+		os.Exit(1)
+	}
+	otel.SetTracerProvider(tracerProvider)
+	defer func() {
+		if err := tracerProvider.Shutdown(context.Background()); err != nil {
+			logger.Log("err", err)
+		}
+	}()
+	tracer := tracerProvider.Tracer("stringsvc")
+
+	var svc stringservice.StringService
+	svc = stringservice.New()
+	svc = stringservice.InstrumentingMiddleware(requestCount, errorCount, requestLatency)(svc)
+	svc = stringservice.LoggingMiddleware(logger)(svc)
+
+	eps := endpoint.MakeServerEndpoints(svc)
+	eps.UppercaseEndpoint = tracing.EndpointMiddleware(tracer, "uppercase")(eps.UppercaseEndpoint)
+	eps.CountEndpoint = tracing.EndpointMiddleware(tracer, "count")(eps.CountEndpoint)
+	eps.ReverseEndpoint = tracing.EndpointMiddleware(tracer, "reverse")(eps.ReverseEndpoint)
+	eps.TruncateEndpoint = tracing.EndpointMiddleware(tracer, "truncate")(eps.TruncateEndpoint)
+	eps = endpoint.WrapAllWithRateLimit(rate.Limit(100), 100, eps)
+
+	if *consulAddr != "" {
+		registrar, err := newConsulRegistrar(*consulAddr, *httpAddr, *advertise)
+		if err != nil {
+			logger.Log("fatal", err)
+			os.Exit(1)
+		}
+		registrar.Register()
+		defer registrar.Deregister()
+	}
+
+	errs := make(chan error)
+	go func() {
+		c := make(chan os.Signal, 1)
+		signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
+		errs <- fmt.Errorf("%s", <-c)
+	}()
+
+	go func() {
+		mux := http.NewServeMux()
+		mux.Handle("/", httptransport.NewHTTPHandler(eps))
+		mux.Handle("/metrics", promhttp.Handler())
+		mux.HandleFunc("/health", func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+		fmt.Println("listening on", *httpAddr, "(HTTP)")
+		errs <- http.ListenAndServe(*httpAddr, mux)
+	}()
+
+	go func() {
+		listener, err := net.Listen("tcp", *grpcAddr)
+		if err != nil {
+			errs <- err
+			return
+		}
+		fmt.Println("listening on", *grpcAddr, "(gRPC)")
+		srv := grpc.NewServer(grpc.StatsHandler(otelgrpc.NewServerHandler()))
+		pb.RegisterStringServiceServer(srv, grpctransport.NewGRPCServer(eps))
+		errs <- srv.Serve(listener)
+	}()
+
+	if *natsAddr != "" {
+		nc, err := nats.Connect(*natsAddr)
+		if err != nil {
+			errs <- err
+		} else {
+			subs, err := natstransport.Subscribe(nc, eps)
+			if err != nil {
+				errs <- err
+			} else {
+				fmt.Println("subscribed to", *natsAddr, "(NATS)")
+				defer func() {
+					for _, s := range subs {
+						_ = s.Unsubscribe()
+					}
+					nc.Close()
+				}()
+			}
+		}
+	}
+
+	fmt.Println("exit", <-errs)
+}
+
+// newConsulRegistrar builds a consulsd.Registrar that registers this
+// instance as "stringsvc" at advertiseAddr (or, if empty, the local
+// hostname combined with httpAddr's port) and deregisters it on
+// Deregister.
+func newConsulRegistrar(consulAddr, httpAddr, advertiseAddr string) (*consulsd.Registrar, error) {
+	consulConfig := api.DefaultConfig()
+	consulConfig.Address = consulAddr
+	client, err := api.NewClient(consulConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if advertiseAddr == "" {
+		host, err := os.Hostname()
+		if err != nil {
+			return nil, err
+		}
+		_, port, err := net.SplitHostPort(httpAddr)
+		if err != nil {
+			return nil, err
+		}
+		advertiseAddr = net.JoinHostPort(host, port)
+	}
+	host, portStr, err := net.SplitHostPort(advertiseAddr)
+	if err != nil {
+		return nil, err
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return nil, err
+	}
+
+	registration := &api.AgentServiceRegistration{
+		ID:      "stringsvc-" + advertiseAddr,
+		Name:    "stringsvc",
+		Address: host,
+		Port:    port,
+		Check: &api.AgentServiceCheck{
+			HTTP:     "http://" + advertiseAddr + "/health",
+			Interval: "10s",
+			Timeout:  "1s",
+		},
+	}
+
+	return consulsd.NewRegistrar(consulsd.NewClient(client), registration, log.NewNopLogger()), nil
+}
@@ -0,0 +1,160 @@
+// Package endpoint adapts stringservice.StringService onto the go-kit
+// endpoint.Endpoint abstraction used by every transport.
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+
+	"github.com/daniel-bryant-uk/go-kit-exp/stringservice"
+)
+
+// Endpoints collects all of the endpoints that compose a string service. It's
+// meant to be used as a helper struct, to collect all of the endpoints into a
+// single parameter.
+type Endpoints struct {
+	UppercaseEndpoint endpoint.Endpoint
+	CountEndpoint     endpoint.Endpoint
+	ReverseEndpoint   endpoint.Endpoint
+	TruncateEndpoint  endpoint.Endpoint
+}
+
+// MakeServerEndpoints returns an Endpoints struct where each endpoint invokes
+// the corresponding method on the provided service.
+func MakeServerEndpoints(svc stringservice.StringService) Endpoints {
+	return Endpoints{
+		UppercaseEndpoint: MakeUppercaseEndpoint(svc),
+		CountEndpoint:     MakeCountEndpoint(svc),
+		ReverseEndpoint:   MakeReverseEndpoint(svc),
+		TruncateEndpoint:  MakeTruncateEndpoint(svc),
+	}
+}
+
+// MakeUppercaseEndpoint returns an endpoint that invokes Uppercase on the
+// service. Errors are returned directly rather than embedded in the
+// response, so transports can map them to the appropriate status codes.
+func MakeUppercaseEndpoint(svc stringservice.StringService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(UppercaseRequest)
+		v, err := svc.Uppercase(ctx, req.S)
+		if err != nil {
+			return nil, err
+		}
+		return UppercaseResponse{V: v}, nil
+	}
+}
+
+// MakeCountEndpoint returns an endpoint that invokes Count on the service.
+func MakeCountEndpoint(svc stringservice.StringService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(CountRequest)
+		v := svc.Count(ctx, req.S)
+		return CountResponse{V: v}, nil
+	}
+}
+
+// MakeReverseEndpoint returns an endpoint that invokes Reverse on the
+// service. Errors are returned directly rather than embedded in the
+// response, so transports can map them to the appropriate status codes.
+func MakeReverseEndpoint(svc stringservice.StringService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ReverseRequest)
+		v, err := svc.Reverse(ctx, req.S)
+		if err != nil {
+			return nil, err
+		}
+		return ReverseResponse{V: v}, nil
+	}
+}
+
+// MakeTruncateEndpoint returns an endpoint that invokes Truncate on the
+// service. Errors are returned directly rather than embedded in the
+// response, so transports can map them to the appropriate status codes.
+func MakeTruncateEndpoint(svc stringservice.StringService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(TruncateRequest)
+		v, err := svc.Truncate(ctx, req.S, req.L)
+		if err != nil {
+			return nil, err
+		}
+		return TruncateResponse{V: v}, nil
+	}
+}
+
+// Uppercase implements StringService. Primarily useful in a client.
+func (e Endpoints) Uppercase(ctx context.Context, s string) (string, error) {
+	resp, err := e.UppercaseEndpoint(ctx, UppercaseRequest{S: s})
+	if err != nil {
+		return "", err
+	}
+	return resp.(UppercaseResponse).V, nil
+}
+
+// Count implements StringService. Primarily useful in a client.
+func (e Endpoints) Count(ctx context.Context, s string) int {
+	resp, err := e.CountEndpoint(ctx, CountRequest{S: s})
+	if err != nil {
+		return 0
+	}
+	return resp.(CountResponse).V
+}
+
+// Reverse implements StringService. Primarily useful in a client.
+func (e Endpoints) Reverse(ctx context.Context, s string) (string, error) {
+	resp, err := e.ReverseEndpoint(ctx, ReverseRequest{S: s})
+	if err != nil {
+		return "", err
+	}
+	return resp.(ReverseResponse).V, nil
+}
+
+// Truncate implements StringService. Primarily useful in a client.
+func (e Endpoints) Truncate(ctx context.Context, s string, l int) (string, error) {
+	resp, err := e.TruncateEndpoint(ctx, TruncateRequest{S: s, L: l})
+	if err != nil {
+		return "", err
+	}
+	return resp.(TruncateResponse).V, nil
+}
+
+// UppercaseRequest collects the request parameters for the Uppercase method.
+type UppercaseRequest struct {
+	S string `json:"s"`
+}
+
+// UppercaseResponse collects the response values for the Uppercase method.
+type UppercaseResponse struct {
+	V string `json:"v"`
+}
+
+// CountRequest collects the request parameters for the Count method.
+type CountRequest struct {
+	S string `json:"s"`
+}
+
+// CountResponse collects the response values for the Count method.
+type CountResponse struct {
+	V int `json:"v"`
+}
+
+// ReverseRequest collects the request parameters for the Reverse method.
+type ReverseRequest struct {
+	S string `json:"s"`
+}
+
+// ReverseResponse collects the response values for the Reverse method.
+type ReverseResponse struct {
+	V string `json:"v"`
+}
+
+// TruncateRequest collects the request parameters for the Truncate method.
+type TruncateRequest struct {
+	S string `json:"s"`
+	L int    `json:"l"`
+}
+
+// TruncateResponse collects the response values for the Truncate method.
+type TruncateResponse struct {
+	V string `json:"v"`
+}
@@ -0,0 +1,28 @@
+package endpoint
+
+import (
+	"golang.org/x/time/rate"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/ratelimit"
+)
+
+// NewRateLimiter wraps an endpoint so that calls exceeding the given
+// requests-per-second limit fail fast with ratelimit.ErrLimited instead of
+// being served.
+func NewRateLimiter(limit rate.Limit, burst int) endpoint.Middleware {
+	return ratelimit.NewErroringLimiter(rate.NewLimiter(limit, burst))
+}
+
+// WrapAllWithRateLimit applies the given requests-per-second limit to every
+// endpoint in e and returns the resulting, wrapped set. Each endpoint gets
+// its own independent limiter, so the budget isn't shared across methods —
+// a burst against one endpoint can't starve the others.
+func WrapAllWithRateLimit(limit rate.Limit, burst int, e Endpoints) Endpoints {
+	return Endpoints{
+		UppercaseEndpoint: NewRateLimiter(limit, burst)(e.UppercaseEndpoint),
+		CountEndpoint:     NewRateLimiter(limit, burst)(e.CountEndpoint),
+		ReverseEndpoint:   NewRateLimiter(limit, burst)(e.ReverseEndpoint),
+		TruncateEndpoint:  NewRateLimiter(limit, burst)(e.TruncateEndpoint),
+	}
+}
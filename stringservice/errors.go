@@ -0,0 +1,22 @@
+package stringservice
+
+import "errors"
+
+// MaxInputSize is the largest input, in bytes, that Uppercase, Reverse and
+// Truncate will operate on. Longer inputs are rejected with
+// ErrMaxSizeExceeded. Count has no error return and counts inputs of any
+// size.
+const MaxInputSize = 1 << 20 // 1 MiB
+
+var (
+	// ErrEmpty is returned when an input string is empty.
+	ErrEmpty = errors.New("empty string")
+
+	// ErrTruncateOutOfRange is returned by Truncate when the requested
+	// length is negative or longer than the input string.
+	ErrTruncateOutOfRange = errors.New("truncate length out of range")
+
+	// ErrMaxSizeExceeded is returned when an input string is larger than
+	// MaxInputSize.
+	ErrMaxSizeExceeded = errors.New("input exceeds maximum size")
+)
@@ -0,0 +1,127 @@
+package stringservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/go-kit/kit/metrics"
+)
+
+// Middleware describes a service (as opposed to endpoint) middleware. It
+// takes the next StringService in the chain and returns a decorated one.
+type Middleware func(StringService) StringService
+
+// LoggingMiddleware returns a service middleware that logs method name,
+// input length, output, error, and elapsed time for every call.
+func LoggingMiddleware(logger log.Logger) Middleware {
+	return func(next StringService) StringService {
+		return loggingMiddleware{logger, next}
+	}
+}
+
+type loggingMiddleware struct {
+	logger log.Logger
+	next   StringService
+}
+
+func (mw loggingMiddleware) Uppercase(ctx context.Context, s string) (output string, err error) {
+	defer func(begin time.Time) {
+		level.Info(mw.logger).Log(
+			"method", "uppercase",
+			"input_len", len(s),
+			"output", output,
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	return mw.next.Uppercase(ctx, s)
+}
+
+func (mw loggingMiddleware) Count(ctx context.Context, s string) (n int) {
+	defer func(begin time.Time) {
+		level.Info(mw.logger).Log(
+			"method", "count",
+			"input_len", len(s),
+			"output", n,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	return mw.next.Count(ctx, s)
+}
+
+func (mw loggingMiddleware) Reverse(ctx context.Context, s string) (output string, err error) {
+	defer func(begin time.Time) {
+		level.Info(mw.logger).Log(
+			"method", "reverse",
+			"input_len", len(s),
+			"output", output,
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	return mw.next.Reverse(ctx, s)
+}
+
+func (mw loggingMiddleware) Truncate(ctx context.Context, s string, l int) (output string, err error) {
+	defer func(begin time.Time) {
+		level.Info(mw.logger).Log(
+			"method", "truncate",
+			"input_len", len(s),
+			"output", output,
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	return mw.next.Truncate(ctx, s, l)
+}
+
+// InstrumentingMiddleware returns a service middleware that records request
+// count, error count, and request latency to the given metrics, per method.
+func InstrumentingMiddleware(requestCount metrics.Counter, errorCount metrics.Counter, requestLatency metrics.Histogram) Middleware {
+	return func(next StringService) StringService {
+		return instrumentingMiddleware{
+			requestCount:   requestCount,
+			errorCount:     errorCount,
+			requestLatency: requestLatency,
+			next:           next,
+		}
+	}
+}
+
+type instrumentingMiddleware struct {
+	requestCount   metrics.Counter
+	errorCount     metrics.Counter
+	requestLatency metrics.Histogram
+	next           StringService
+}
+
+func (mw instrumentingMiddleware) Uppercase(ctx context.Context, s string) (output string, err error) {
+	defer mw.observe("uppercase", time.Now(), &err)
+	return mw.next.Uppercase(ctx, s)
+}
+
+func (mw instrumentingMiddleware) Count(ctx context.Context, s string) (n int) {
+	defer mw.observe("count", time.Now(), nil)
+	return mw.next.Count(ctx, s)
+}
+
+func (mw instrumentingMiddleware) Reverse(ctx context.Context, s string) (output string, err error) {
+	defer mw.observe("reverse", time.Now(), &err)
+	return mw.next.Reverse(ctx, s)
+}
+
+func (mw instrumentingMiddleware) Truncate(ctx context.Context, s string, l int) (output string, err error) {
+	defer mw.observe("truncate", time.Now(), &err)
+	return mw.next.Truncate(ctx, s, l)
+}
+
+func (mw instrumentingMiddleware) observe(method string, begin time.Time, err *error) {
+	lvs := []string{"method", method}
+	if err != nil && *err != nil {
+		mw.errorCount.With(lvs...).Add(1)
+	}
+	mw.requestCount.With(lvs...).Add(1)
+	mw.requestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+}
@@ -0,0 +1,66 @@
+// Package stringservice provides the core string manipulation business
+// logic, free of any transport or endpoint concerns.
+package stringservice
+
+import (
+	"context"
+	"strings"
+)
+
+// StringService provides operations on strings.
+type StringService interface {
+	Uppercase(ctx context.Context, s string) (string, error)
+	Count(ctx context.Context, s string) int
+	Reverse(ctx context.Context, s string) (string, error)
+	Truncate(ctx context.Context, s string, l int) (string, error)
+}
+
+type stringService struct{}
+
+// New returns a basic, in-memory implementation of StringService.
+func New() StringService {
+	return stringService{}
+}
+
+func (stringService) Uppercase(_ context.Context, s string) (string, error) {
+	if s == "" {
+		return "", ErrEmpty
+	}
+	if len(s) > MaxInputSize {
+		return "", ErrMaxSizeExceeded
+	}
+	return strings.ToUpper(s), nil
+}
+
+func (stringService) Count(_ context.Context, s string) int {
+	return len(s)
+}
+
+func (stringService) Reverse(_ context.Context, s string) (string, error) {
+	if s == "" {
+		return "", ErrEmpty
+	}
+	if len(s) > MaxInputSize {
+		return "", ErrMaxSizeExceeded
+	}
+
+	r := []rune(s)
+	for i, j := 0, len(r)-1; i < len(r)/2; i, j = i+1, j-1 {
+		r[i], r[j] = r[j], r[i]
+	}
+	return string(r), nil
+}
+
+func (stringService) Truncate(_ context.Context, s string, l int) (string, error) {
+	if s == "" {
+		return "", ErrEmpty
+	}
+	if len(s) > MaxInputSize {
+		return "", ErrMaxSizeExceeded
+	}
+	if l < 0 || l > len(s) {
+		return "", ErrTruncateOutOfRange
+	}
+
+	return s[:l], nil
+}
@@ -0,0 +1,42 @@
+package stringservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestTruncate(t *testing.T) {
+	svc := New()
+
+	cases := []struct {
+		name    string
+		in      string
+		l       int
+		want    string
+		wantErr error
+	}{
+		{name: "ok", in: "hello", l: 3, want: "hel"},
+		{name: "empty input", in: "", l: 0, wantErr: ErrEmpty},
+		{name: "negative length", in: "hello", l: -1, wantErr: ErrTruncateOutOfRange},
+		{name: "length past end", in: "hello", l: 6, wantErr: ErrTruncateOutOfRange},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := svc.Truncate(context.Background(), c.in, c.l)
+			if c.wantErr != nil {
+				if !errors.Is(err, c.wantErr) {
+					t.Fatalf("Truncate(%q, %d) err = %v, want %v", c.in, c.l, err, c.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Truncate(%q, %d) unexpected err: %v", c.in, c.l, err)
+			}
+			if got != c.want {
+				t.Fatalf("Truncate(%q, %d) = %q, want %q", c.in, c.l, got, c.want)
+			}
+		})
+	}
+}
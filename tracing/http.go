@@ -0,0 +1,28 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	httptransport "github.com/go-kit/kit/transport/http"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// HTTPServerBefore returns an httptransport.RequestFunc that extracts a
+// W3C traceparent header from an inbound request into the context, so that
+// EndpointMiddleware spans become children of the caller's span.
+func HTTPServerBefore() httptransport.RequestFunc {
+	return func(ctx context.Context, r *http.Request) context.Context {
+		return otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(r.Header))
+	}
+}
+
+// HTTPClientBefore returns an httptransport.RequestFunc that injects the
+// current span's W3C traceparent header into an outbound request.
+func HTTPClientBefore() httptransport.RequestFunc {
+	return func(ctx context.Context, r *http.Request) context.Context {
+		otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(r.Header))
+		return ctx
+	}
+}
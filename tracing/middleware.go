@@ -0,0 +1,70 @@
+// Package tracing provides OpenTelemetry instrumentation for the string
+// service's endpoints and transports.
+package tracing
+
+import (
+	"context"
+
+	kitendpoint "github.com/go-kit/kit/endpoint"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/daniel-bryant-uk/go-kit-exp/endpoint"
+	"github.com/daniel-bryant-uk/go-kit-exp/stringservice"
+)
+
+// EndpointMiddleware returns an endpoint.Middleware that starts a span named
+// after operationName around the wrapped endpoint, recording input length,
+// truncation limit, and error class as attributes.
+func EndpointMiddleware(tracer trace.Tracer, operationName string) kitendpoint.Middleware {
+	return func(next kitendpoint.Endpoint) kitendpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			ctx, span := tracer.Start(ctx, operationName)
+			defer span.End()
+
+			span.SetAttributes(requestAttributes(request)...)
+
+			response, err := next(ctx, request)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+				span.SetAttributes(attribute.String("error.class", errorClass(err)))
+			}
+			return response, err
+		}
+	}
+}
+
+func requestAttributes(request interface{}) []attribute.KeyValue {
+	switch req := request.(type) {
+	case endpoint.UppercaseRequest:
+		return []attribute.KeyValue{attribute.Int("input_len", len(req.S))}
+	case endpoint.CountRequest:
+		return []attribute.KeyValue{attribute.Int("input_len", len(req.S))}
+	case endpoint.ReverseRequest:
+		return []attribute.KeyValue{attribute.Int("input_len", len(req.S))}
+	case endpoint.TruncateRequest:
+		return []attribute.KeyValue{
+			attribute.Int("input_len", len(req.S)),
+			attribute.Int("truncate_limit", req.L),
+		}
+	default:
+		return nil
+	}
+}
+
+// errorClass classifies an error into a short, low-cardinality label
+// suitable for a span attribute.
+func errorClass(err error) string {
+	switch {
+	case err == stringservice.ErrEmpty:
+		return "empty_string"
+	case err == stringservice.ErrTruncateOutOfRange:
+		return "truncate_out_of_range"
+	case err == stringservice.ErrMaxSizeExceeded:
+		return "max_size_exceeded"
+	default:
+		return "internal"
+	}
+}
@@ -0,0 +1,224 @@
+// Package grpc provides a gRPC transport for the string service.
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	endpoints "github.com/daniel-bryant-uk/go-kit-exp/endpoint"
+	"github.com/daniel-bryant-uk/go-kit-exp/stringservice"
+	"github.com/daniel-bryant-uk/go-kit-exp/transport/grpc/pb"
+)
+
+// grpcError maps a domain error to a gRPC status error so clients can branch
+// on codes.Code rather than parsing error strings.
+func grpcError(err error) error {
+	switch {
+	case errors.Is(err, stringservice.ErrEmpty), errors.Is(err, stringservice.ErrTruncateOutOfRange):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, stringservice.ErrMaxSizeExceeded):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+type grpcServer struct {
+	uppercase grpctransport.Handler
+	count     grpctransport.Handler
+	reverse   grpctransport.Handler
+	truncate  grpctransport.Handler
+
+	pb.UnimplementedStringServiceServer
+}
+
+// NewGRPCServer makes a set of endpoints available as a gRPC
+// StringServiceServer.
+func NewGRPCServer(eps endpoints.Endpoints) pb.StringServiceServer {
+	return &grpcServer{
+		uppercase: grpctransport.NewServer(
+			eps.UppercaseEndpoint,
+			decodeGRPCUppercaseRequest,
+			encodeGRPCUppercaseResponse,
+		),
+		count: grpctransport.NewServer(
+			eps.CountEndpoint,
+			decodeGRPCCountRequest,
+			encodeGRPCCountResponse,
+		),
+		reverse: grpctransport.NewServer(
+			eps.ReverseEndpoint,
+			decodeGRPCReverseRequest,
+			encodeGRPCReverseResponse,
+		),
+		truncate: grpctransport.NewServer(
+			eps.TruncateEndpoint,
+			decodeGRPCTruncateRequest,
+			encodeGRPCTruncateResponse,
+		),
+	}
+}
+
+func (s *grpcServer) Uppercase(ctx context.Context, req *pb.UppercaseRequest) (*pb.UppercaseReply, error) {
+	_, rep, err := s.uppercase.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return rep.(*pb.UppercaseReply), nil
+}
+
+func (s *grpcServer) Count(ctx context.Context, req *pb.CountRequest) (*pb.CountReply, error) {
+	_, rep, err := s.count.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return rep.(*pb.CountReply), nil
+}
+
+func (s *grpcServer) Reverse(ctx context.Context, req *pb.ReverseRequest) (*pb.ReverseReply, error) {
+	_, rep, err := s.reverse.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return rep.(*pb.ReverseReply), nil
+}
+
+func (s *grpcServer) Truncate(ctx context.Context, req *pb.TruncateRequest) (*pb.TruncateReply, error) {
+	_, rep, err := s.truncate.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return rep.(*pb.TruncateReply), nil
+}
+
+func decodeGRPCUppercaseRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.UppercaseRequest)
+	return endpoints.UppercaseRequest{S: req.S}, nil
+}
+
+func encodeGRPCUppercaseResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(endpoints.UppercaseResponse)
+	return &pb.UppercaseReply{V: resp.V}, nil
+}
+
+func decodeGRPCCountRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.CountRequest)
+	return endpoints.CountRequest{S: req.S}, nil
+}
+
+func encodeGRPCCountResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(endpoints.CountResponse)
+	return &pb.CountReply{V: int64(resp.V)}, nil
+}
+
+func decodeGRPCReverseRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ReverseRequest)
+	return endpoints.ReverseRequest{S: req.S}, nil
+}
+
+func encodeGRPCReverseResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(endpoints.ReverseResponse)
+	return &pb.ReverseReply{V: resp.V}, nil
+}
+
+func decodeGRPCTruncateRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.TruncateRequest)
+	return endpoints.TruncateRequest{S: req.S, L: int(req.L)}, nil
+}
+
+func encodeGRPCTruncateResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(endpoints.TruncateResponse)
+	return &pb.TruncateReply{V: resp.V}, nil
+}
+
+// NewGRPCClient returns a StringService backed by a gRPC server at the other
+// end of the conn.
+func NewGRPCClient(conn *grpc.ClientConn) endpoints.Endpoints {
+	uppercaseEndpoint := grpctransport.NewClient(
+		conn,
+		"pb.StringService",
+		"Uppercase",
+		encodeGRPCUppercaseRequest,
+		decodeGRPCUppercaseResponse,
+		pb.UppercaseReply{},
+	).Endpoint()
+
+	countEndpoint := grpctransport.NewClient(
+		conn,
+		"pb.StringService",
+		"Count",
+		encodeGRPCCountRequest,
+		decodeGRPCCountResponse,
+		pb.CountReply{},
+	).Endpoint()
+
+	reverseEndpoint := grpctransport.NewClient(
+		conn,
+		"pb.StringService",
+		"Reverse",
+		encodeGRPCReverseRequest,
+		decodeGRPCReverseResponse,
+		pb.ReverseReply{},
+	).Endpoint()
+
+	truncateEndpoint := grpctransport.NewClient(
+		conn,
+		"pb.StringService",
+		"Truncate",
+		encodeGRPCTruncateRequest,
+		decodeGRPCTruncateResponse,
+		pb.TruncateReply{},
+	).Endpoint()
+
+	return endpoints.Endpoints{
+		UppercaseEndpoint: uppercaseEndpoint,
+		CountEndpoint:     countEndpoint,
+		ReverseEndpoint:   reverseEndpoint,
+		TruncateEndpoint:  truncateEndpoint,
+	}
+}
+
+func encodeGRPCUppercaseRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(endpoints.UppercaseRequest)
+	return &pb.UppercaseRequest{S: req.S}, nil
+}
+
+func decodeGRPCUppercaseResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.UppercaseReply)
+	return endpoints.UppercaseResponse{V: reply.V}, nil
+}
+
+func encodeGRPCCountRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(endpoints.CountRequest)
+	return &pb.CountRequest{S: req.S}, nil
+}
+
+func decodeGRPCCountResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.CountReply)
+	return endpoints.CountResponse{V: int(reply.V)}, nil
+}
+
+func encodeGRPCReverseRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(endpoints.ReverseRequest)
+	return &pb.ReverseRequest{S: req.S}, nil
+}
+
+func decodeGRPCReverseResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.ReverseReply)
+	return endpoints.ReverseResponse{V: reply.V}, nil
+}
+
+func encodeGRPCTruncateRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(endpoints.TruncateRequest)
+	return &pb.TruncateRequest{S: req.S, L: int64(req.L)}, nil
+}
+
+func decodeGRPCTruncateResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.TruncateReply)
+	return endpoints.TruncateResponse{V: reply.V}, nil
+}
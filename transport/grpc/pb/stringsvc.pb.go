@@ -0,0 +1,321 @@
+// Package pb holds the gRPC message and client/server types for
+// stringsvc.proto.
+//
+// This file is maintained by hand, not generated by protoc-gen-go: the repo
+// has no protoc/buf toolchain wired up. It mirrors stringsvc.proto, which is
+// the source of truth for the wire schema — keep the two in sync when either
+// changes, and regenerate properly (protoc --go_out=. --go-grpc_out=.
+// stringsvc.proto, or the buf equivalent) if that toolchain ever gets added.
+package pb
+
+import (
+	context "context"
+	fmt "fmt"
+
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+
+type UppercaseRequest struct {
+	S string `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
+}
+
+func (m *UppercaseRequest) Reset()         { *m = UppercaseRequest{} }
+func (m *UppercaseRequest) String() string { return proto.CompactTextString(m) }
+func (*UppercaseRequest) ProtoMessage()    {}
+
+func (m *UppercaseRequest) GetS() string {
+	if m != nil {
+		return m.S
+	}
+	return ""
+}
+
+type UppercaseReply struct {
+	V string `protobuf:"bytes,1,opt,name=v,proto3" json:"v,omitempty"`
+}
+
+func (m *UppercaseReply) Reset()         { *m = UppercaseReply{} }
+func (m *UppercaseReply) String() string { return proto.CompactTextString(m) }
+func (*UppercaseReply) ProtoMessage()    {}
+
+func (m *UppercaseReply) GetV() string {
+	if m != nil {
+		return m.V
+	}
+	return ""
+}
+
+type CountRequest struct {
+	S string `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
+}
+
+func (m *CountRequest) Reset()         { *m = CountRequest{} }
+func (m *CountRequest) String() string { return proto.CompactTextString(m) }
+func (*CountRequest) ProtoMessage()    {}
+
+func (m *CountRequest) GetS() string {
+	if m != nil {
+		return m.S
+	}
+	return ""
+}
+
+type CountReply struct {
+	V int64 `protobuf:"varint,1,opt,name=v,proto3" json:"v,omitempty"`
+}
+
+func (m *CountReply) Reset()         { *m = CountReply{} }
+func (m *CountReply) String() string { return proto.CompactTextString(m) }
+func (*CountReply) ProtoMessage()    {}
+
+func (m *CountReply) GetV() int64 {
+	if m != nil {
+		return m.V
+	}
+	return 0
+}
+
+type ReverseRequest struct {
+	S string `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
+}
+
+func (m *ReverseRequest) Reset()         { *m = ReverseRequest{} }
+func (m *ReverseRequest) String() string { return proto.CompactTextString(m) }
+func (*ReverseRequest) ProtoMessage()    {}
+
+func (m *ReverseRequest) GetS() string {
+	if m != nil {
+		return m.S
+	}
+	return ""
+}
+
+type ReverseReply struct {
+	V string `protobuf:"bytes,1,opt,name=v,proto3" json:"v,omitempty"`
+}
+
+func (m *ReverseReply) Reset()         { *m = ReverseReply{} }
+func (m *ReverseReply) String() string { return proto.CompactTextString(m) }
+func (*ReverseReply) ProtoMessage()    {}
+
+func (m *ReverseReply) GetV() string {
+	if m != nil {
+		return m.V
+	}
+	return ""
+}
+
+type TruncateRequest struct {
+	S string `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
+	L int64  `protobuf:"varint,2,opt,name=l,proto3" json:"l,omitempty"`
+}
+
+func (m *TruncateRequest) Reset()         { *m = TruncateRequest{} }
+func (m *TruncateRequest) String() string { return proto.CompactTextString(m) }
+func (*TruncateRequest) ProtoMessage()    {}
+
+func (m *TruncateRequest) GetS() string {
+	if m != nil {
+		return m.S
+	}
+	return ""
+}
+
+func (m *TruncateRequest) GetL() int64 {
+	if m != nil {
+		return m.L
+	}
+	return 0
+}
+
+type TruncateReply struct {
+	V string `protobuf:"bytes,1,opt,name=v,proto3" json:"v,omitempty"`
+}
+
+func (m *TruncateReply) Reset()         { *m = TruncateReply{} }
+func (m *TruncateReply) String() string { return proto.CompactTextString(m) }
+func (*TruncateReply) ProtoMessage()    {}
+
+func (m *TruncateReply) GetV() string {
+	if m != nil {
+		return m.V
+	}
+	return ""
+}
+
+// StringServiceClient is the client API for StringService service.
+type StringServiceClient interface {
+	Uppercase(ctx context.Context, in *UppercaseRequest, opts ...grpc.CallOption) (*UppercaseReply, error)
+	Count(ctx context.Context, in *CountRequest, opts ...grpc.CallOption) (*CountReply, error)
+	Reverse(ctx context.Context, in *ReverseRequest, opts ...grpc.CallOption) (*ReverseReply, error)
+	Truncate(ctx context.Context, in *TruncateRequest, opts ...grpc.CallOption) (*TruncateReply, error)
+}
+
+type stringServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+// NewStringServiceClient constructs a client for the StringService gRPC
+// service.
+func NewStringServiceClient(cc *grpc.ClientConn) StringServiceClient {
+	return &stringServiceClient{cc}
+}
+
+func (c *stringServiceClient) Uppercase(ctx context.Context, in *UppercaseRequest, opts ...grpc.CallOption) (*UppercaseReply, error) {
+	out := new(UppercaseReply)
+	err := c.cc.Invoke(ctx, "/pb.StringService/Uppercase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) Count(ctx context.Context, in *CountRequest, opts ...grpc.CallOption) (*CountReply, error) {
+	out := new(CountReply)
+	err := c.cc.Invoke(ctx, "/pb.StringService/Count", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) Reverse(ctx context.Context, in *ReverseRequest, opts ...grpc.CallOption) (*ReverseReply, error) {
+	out := new(ReverseReply)
+	err := c.cc.Invoke(ctx, "/pb.StringService/Reverse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) Truncate(ctx context.Context, in *TruncateRequest, opts ...grpc.CallOption) (*TruncateReply, error) {
+	out := new(TruncateReply)
+	err := c.cc.Invoke(ctx, "/pb.StringService/Truncate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StringServiceServer is the server API for StringService service.
+type StringServiceServer interface {
+	Uppercase(context.Context, *UppercaseRequest) (*UppercaseReply, error)
+	Count(context.Context, *CountRequest) (*CountReply, error)
+	Reverse(context.Context, *ReverseRequest) (*ReverseReply, error)
+	Truncate(context.Context, *TruncateRequest) (*TruncateReply, error)
+}
+
+// UnimplementedStringServiceServer can be embedded to have forward compatible
+// implementations.
+type UnimplementedStringServiceServer struct{}
+
+func (*UnimplementedStringServiceServer) Uppercase(context.Context, *UppercaseRequest) (*UppercaseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Uppercase not implemented")
+}
+func (*UnimplementedStringServiceServer) Count(context.Context, *CountRequest) (*CountReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Count not implemented")
+}
+func (*UnimplementedStringServiceServer) Reverse(context.Context, *ReverseRequest) (*ReverseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reverse not implemented")
+}
+func (*UnimplementedStringServiceServer) Truncate(context.Context, *TruncateRequest) (*TruncateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Truncate not implemented")
+}
+
+// RegisterStringServiceServer registers srv as the implementation backing
+// the StringService gRPC service on s.
+func RegisterStringServiceServer(s *grpc.Server, srv StringServiceServer) {
+	s.RegisterService(&_StringService_serviceDesc, srv)
+}
+
+func _StringService_Uppercase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UppercaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).Uppercase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/Uppercase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).Uppercase(ctx, req.(*UppercaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StringService_Count_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).Count(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/Count",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).Count(ctx, req.(*CountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StringService_Reverse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).Reverse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/Reverse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).Reverse(ctx, req.(*ReverseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StringService_Truncate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TruncateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).Truncate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.StringService/Truncate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).Truncate(ctx, req.(*TruncateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _StringService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.StringService",
+	HandlerType: (*StringServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "Uppercase", Handler: _StringService_Uppercase_Handler},
+		{MethodName: "Count", Handler: _StringService_Count_Handler},
+		{MethodName: "Reverse", Handler: _StringService_Reverse_Handler},
+		{MethodName: "Truncate", Handler: _StringService_Truncate_Handler},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stringsvc.proto",
+}
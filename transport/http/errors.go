@@ -0,0 +1,69 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/daniel-bryant-uk/go-kit-exp/stringservice"
+)
+
+// errorResponse is the JSON body written for any endpoint error.
+type errorResponse struct {
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// encodeError writes the given HTTP status and a consistent JSON error body
+// carrying err's message and code.
+func encodeError(_ context.Context, err error, w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(errorResponse{Error: err.Error(), Code: code})
+}
+
+// errorEncoder maps a domain error to an HTTP status code and error code and
+// writes them with encodeError. It's installed on every server as a
+// httptransport.ServerErrorEncoder.
+func errorEncoder(ctx context.Context, err error, w http.ResponseWriter) {
+	switch {
+	case errors.Is(err, stringservice.ErrEmpty):
+		encodeError(ctx, err, w, http.StatusBadRequest, "empty_string")
+	case errors.Is(err, stringservice.ErrTruncateOutOfRange):
+		encodeError(ctx, err, w, http.StatusBadRequest, "truncate_out_of_range")
+	case errors.Is(err, stringservice.ErrMaxSizeExceeded):
+		encodeError(ctx, err, w, http.StatusRequestEntityTooLarge, "max_size_exceeded")
+	default:
+		encodeError(ctx, err, w, http.StatusInternalServerError, "internal")
+	}
+}
+
+// checkResponse returns a non-nil error for any non-2xx response, decoding
+// the errorResponse body written by errorEncoder. Known codes are mapped
+// back to their stringservice sentinel so callers of the HTTP client can
+// errors.Is against them; unrecognized codes are returned as a plain error
+// carrying the server's message. The gRPC and NATS clients do not map
+// errors back to the sentinels.
+func checkResponse(r *http.Response) error {
+	if r.StatusCode >= 200 && r.StatusCode < 300 {
+		return nil
+	}
+
+	var body errorResponse
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return fmt.Errorf("http: request failed with status %d", r.StatusCode)
+	}
+
+	switch body.Code {
+	case "empty_string":
+		return stringservice.ErrEmpty
+	case "truncate_out_of_range":
+		return stringservice.ErrTruncateOutOfRange
+	case "max_size_exceeded":
+		return stringservice.ErrMaxSizeExceeded
+	default:
+		return errors.New(body.Error)
+	}
+}
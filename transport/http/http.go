@@ -0,0 +1,162 @@
+// Package http provides an HTTP transport for the string service, built on
+// top of the endpoint package.
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	"github.com/daniel-bryant-uk/go-kit-exp/endpoint"
+	"github.com/daniel-bryant-uk/go-kit-exp/tracing"
+)
+
+// NewHTTPHandler returns an HTTP handler that makes a set of endpoints
+// available on predefined paths.
+func NewHTTPHandler(endpoints endpoint.Endpoints) http.Handler {
+	m := http.NewServeMux()
+	m.Handle("/uppercase", httptransport.NewServer(
+		endpoints.UppercaseEndpoint,
+		decodeUppercaseRequest,
+		encodeResponse,
+		httptransport.ServerErrorEncoder(errorEncoder),
+		httptransport.ServerBefore(tracing.HTTPServerBefore()),
+	))
+	m.Handle("/count", httptransport.NewServer(
+		endpoints.CountEndpoint,
+		decodeCountRequest,
+		encodeResponse,
+		httptransport.ServerErrorEncoder(errorEncoder),
+		httptransport.ServerBefore(tracing.HTTPServerBefore()),
+	))
+	m.Handle("/reverse", httptransport.NewServer(
+		endpoints.ReverseEndpoint,
+		decodeReverseRequest,
+		encodeResponse,
+		httptransport.ServerErrorEncoder(errorEncoder),
+		httptransport.ServerBefore(tracing.HTTPServerBefore()),
+	))
+	m.Handle("/truncate", httptransport.NewServer(
+		endpoints.TruncateEndpoint,
+		decodeTruncateRequest,
+		encodeResponse,
+		httptransport.ServerErrorEncoder(errorEncoder),
+		httptransport.ServerBefore(tracing.HTTPServerBefore()),
+	))
+	return m
+}
+
+// NewHTTPClient returns a StringService backed by an HTTP server living at
+// the remote instance. instance is scheme://host:port, e.g.
+// "http://localhost:8080".
+func NewHTTPClient(instance string) (endpoint.Endpoints, error) {
+	tgt, err := url.Parse(instance)
+	if err != nil {
+		return endpoint.Endpoints{}, err
+	}
+
+	return endpoint.Endpoints{
+		UppercaseEndpoint: httptransport.NewClient(
+			"POST", copyURL(tgt, "/uppercase"),
+			httptransport.EncodeJSONRequest, decodeUppercaseResponse,
+			httptransport.ClientBefore(tracing.HTTPClientBefore()),
+		).Endpoint(),
+		CountEndpoint: httptransport.NewClient(
+			"POST", copyURL(tgt, "/count"),
+			httptransport.EncodeJSONRequest, decodeCountResponse,
+			httptransport.ClientBefore(tracing.HTTPClientBefore()),
+		).Endpoint(),
+		ReverseEndpoint: httptransport.NewClient(
+			"POST", copyURL(tgt, "/reverse"),
+			httptransport.EncodeJSONRequest, decodeReverseResponse,
+			httptransport.ClientBefore(tracing.HTTPClientBefore()),
+		).Endpoint(),
+		TruncateEndpoint: httptransport.NewClient(
+			"POST", copyURL(tgt, "/truncate"),
+			httptransport.EncodeJSONRequest, decodeTruncateResponse,
+			httptransport.ClientBefore(tracing.HTTPClientBefore()),
+		).Endpoint(),
+	}, nil
+}
+
+func copyURL(base *url.URL, path string) *url.URL {
+	u := *base
+	u.Path = path
+	return &u
+}
+
+func decodeUppercaseResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	if err := checkResponse(r); err != nil {
+		return nil, err
+	}
+	var resp endpoint.UppercaseResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+func decodeCountResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	if err := checkResponse(r); err != nil {
+		return nil, err
+	}
+	var resp endpoint.CountResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+func decodeReverseResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	if err := checkResponse(r); err != nil {
+		return nil, err
+	}
+	var resp endpoint.ReverseResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+func decodeTruncateResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	if err := checkResponse(r); err != nil {
+		return nil, err
+	}
+	var resp endpoint.TruncateResponse
+	err := json.NewDecoder(r.Body).Decode(&resp)
+	return resp, err
+}
+
+func decodeUppercaseRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req endpoint.UppercaseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeCountRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req endpoint.CountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeReverseRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req endpoint.ReverseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeTruncateRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req endpoint.TruncateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func encodeResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	return json.NewEncoder(w).Encode(response)
+}
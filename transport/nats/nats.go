@@ -0,0 +1,185 @@
+// Package nats provides a NATS transport for the string service. Each
+// method is exposed as a request/reply subject under the "stringsvc."
+// prefix.
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/go-kit/kit/endpoint"
+	natstransport "github.com/go-kit/kit/transport/nats"
+	"github.com/nats-io/nats.go"
+
+	endpoints "github.com/daniel-bryant-uk/go-kit-exp/endpoint"
+)
+
+// Subjects used by the NATS transport.
+const (
+	UppercaseSubject = "stringsvc.uppercase"
+	CountSubject     = "stringsvc.count"
+	ReverseSubject   = "stringsvc.reverse"
+	TruncateSubject  = "stringsvc.truncate"
+)
+
+// NewSubscribers returns a NATS subscriber for each method in eps, in the
+// order Uppercase, Count, Reverse, Truncate. The subscribers carry no
+// subject; Subscribe binds each one to its subject with nc.QueueSubscribe.
+func NewSubscribers(nc *nats.Conn, eps endpoints.Endpoints) []*natstransport.Subscriber {
+	return []*natstransport.Subscriber{
+		natstransport.NewSubscriber(
+			eps.UppercaseEndpoint,
+			decodeNATSUppercaseRequest,
+			encodeNATSResponse,
+		),
+		natstransport.NewSubscriber(
+			eps.CountEndpoint,
+			decodeNATSCountRequest,
+			encodeNATSResponse,
+		),
+		natstransport.NewSubscriber(
+			eps.ReverseEndpoint,
+			decodeNATSReverseRequest,
+			encodeNATSResponse,
+		),
+		natstransport.NewSubscriber(
+			eps.TruncateEndpoint,
+			decodeNATSTruncateRequest,
+			encodeNATSResponse,
+		),
+	}
+}
+
+// Subscribe registers a subscriber for each method in eps on its
+// corresponding subject and returns the resulting subscriptions so the
+// caller can unsubscribe on shutdown.
+func Subscribe(nc *nats.Conn, eps endpoints.Endpoints) ([]*nats.Subscription, error) {
+	subs := NewSubscribers(nc, eps)
+	subjects := []string{UppercaseSubject, CountSubject, ReverseSubject, TruncateSubject}
+
+	subscriptions := make([]*nats.Subscription, 0, len(subs))
+	for i, sub := range subs {
+		s, err := nc.QueueSubscribe(subjects[i], "stringsvc", sub.ServeMsg(nc))
+		if err != nil {
+			for _, existing := range subscriptions {
+				_ = existing.Unsubscribe()
+			}
+			return nil, err
+		}
+		subscriptions = append(subscriptions, s)
+	}
+	return subscriptions, nil
+}
+
+func decodeNATSUppercaseRequest(_ context.Context, msg *nats.Msg) (interface{}, error) {
+	var req endpoints.UppercaseRequest
+	if err := json.Unmarshal(msg.Data, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeNATSCountRequest(_ context.Context, msg *nats.Msg) (interface{}, error) {
+	var req endpoints.CountRequest
+	if err := json.Unmarshal(msg.Data, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeNATSReverseRequest(_ context.Context, msg *nats.Msg) (interface{}, error) {
+	var req endpoints.ReverseRequest
+	if err := json.Unmarshal(msg.Data, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeNATSTruncateRequest(_ context.Context, msg *nats.Msg) (interface{}, error) {
+	var req endpoints.TruncateRequest
+	if err := json.Unmarshal(msg.Data, &req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func encodeNATSResponse(_ context.Context, reply string, nc *nats.Conn, response interface{}) error {
+	b, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	return nc.Publish(reply, b)
+}
+
+// NewPublisher returns an endpoint.Endpoint that publishes a request to
+// subject over nc and decodes the reply, for use by clients that want to
+// call the string service over NATS.
+func NewPublisher(nc *nats.Conn, subject string, dec natstransport.DecodeResponseFunc) endpoint.Endpoint {
+	return natstransport.NewPublisher(
+		nc,
+		subject,
+		natstransport.EncodeJSONRequest,
+		dec,
+	).Endpoint()
+}
+
+// NewNATSClient returns a StringService backed by a NATS server reachable
+// over nc, with each method published to its corresponding subject.
+func NewNATSClient(nc *nats.Conn) endpoints.Endpoints {
+	return endpoints.Endpoints{
+		UppercaseEndpoint: NewPublisher(nc, UppercaseSubject, decodeNATSUppercaseResponse),
+		CountEndpoint:     NewPublisher(nc, CountSubject, decodeNATSCountResponse),
+		ReverseEndpoint:   NewPublisher(nc, ReverseSubject, decodeNATSReverseResponse),
+		TruncateEndpoint:  NewPublisher(nc, TruncateSubject, decodeNATSTruncateResponse),
+	}
+}
+
+// natsErrorResponse mirrors the JSON body natstransport.DefaultErrorEncoder
+// writes to the reply subject on a subscriber-side error, which is what
+// NewSubscribers uses since it installs no SubscriberErrorEncoder.
+type natsErrorResponse struct {
+	Err string `json:"err"`
+}
+
+// decodeNATSResponse unmarshals msg into v, unless msg carries a
+// natsErrorResponse, in which case it returns that error instead.
+func decodeNATSResponse(msg *nats.Msg, v interface{}) error {
+	var errResp natsErrorResponse
+	if err := json.Unmarshal(msg.Data, &errResp); err == nil && errResp.Err != "" {
+		return errors.New(errResp.Err)
+	}
+	return json.Unmarshal(msg.Data, v)
+}
+
+func decodeNATSUppercaseResponse(_ context.Context, msg *nats.Msg) (interface{}, error) {
+	var resp endpoints.UppercaseResponse
+	if err := decodeNATSResponse(msg, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func decodeNATSCountResponse(_ context.Context, msg *nats.Msg) (interface{}, error) {
+	var resp endpoints.CountResponse
+	if err := decodeNATSResponse(msg, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func decodeNATSReverseResponse(_ context.Context, msg *nats.Msg) (interface{}, error) {
+	var resp endpoints.ReverseResponse
+	if err := decodeNATSResponse(msg, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func decodeNATSTruncateResponse(_ context.Context, msg *nats.Msg) (interface{}, error) {
+	var resp endpoints.TruncateResponse
+	if err := decodeNATSResponse(msg, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}